@@ -0,0 +1,11 @@
+// Package api embeds the OpenAPI 3 spec describing the v2 HTTP API so it
+// can be served directly from the running binary.
+package api
+
+import _ "embed"
+
+//go:embed openapi3.json
+var OpenAPIJSON []byte
+
+//go:embed openapi3.yml
+var OpenAPIYAML []byte
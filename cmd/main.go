@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
 	"log"
 
+	"github.com/codemodus/uidgen"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+
 	"github.com/GoReactors/backend-learning/config"
 	"github.com/GoReactors/backend-learning/internal/adapter"
-	game_service "github.com/GoReactors/backend-learning/internal/application/game/service"
-	"github.com/joho/godotenv"
+	http_handler "github.com/GoReactors/backend-learning/internal/adapter/handler/http"
+	"github.com/GoReactors/backend-learning/internal/core/port"
+	"github.com/GoReactors/backend-learning/internal/core/service/auth_service"
+	"github.com/GoReactors/backend-learning/internal/core/service/game_service"
 )
 
 func main() {
@@ -17,8 +24,36 @@ func main() {
 
 	cfg := config.LoadConfig()
 
-	gameRepository := adapter.NewGameRepositoryAdapter()
-	gameService := game_service.NewGameService(gameRepository)
-	gameAPIAdapter := adapter.NewGameAPIAdapter(gameService)
+	gamesRepository := newGamesRepository(cfg)
+	usersRepository := adapter.NewUsersRepositoryAdapter()
+
+	uidGenerator, err := uidgen.New()
+	if err != nil {
+		log.Fatalf("Failed to create uid generator: %v", err)
+	}
+
+	gamesService := game_service.New(gamesRepository, *uidGenerator)
+	authService := auth_service.New(usersRepository, *uidGenerator)
+
+	gamesHandler := http_handler.NewHTTPHandler(gamesService)
+	authHandler := http_handler.NewAuthHTTPHandler(authService)
+
+	gameAPIAdapter := adapter.NewGameAPIAdapter(cfg, authHandler, gamesHandler)
 	gameAPIAdapter.Run(cfg)
 }
+
+func newGamesRepository(cfg config.Config) port.GamesRepository {
+	switch cfg.GamesBackend {
+	case "postgres":
+		pool, err := pgxpool.New(context.Background(), cfg.PostgresDSN)
+		if err != nil {
+			log.Fatalf("Failed to connect to postgres: %v", err)
+		}
+		return adapter.NewPostgresGamesRepository(pool)
+	case "memory":
+		return adapter.NewGamesRepositoryAdapter()
+	default:
+		log.Fatalf("Unknown GAMES_BACKEND %q", cfg.GamesBackend)
+		return nil
+	}
+}
@@ -7,6 +7,13 @@ import (
 
 type Config struct {
 	GinAppPort int `envconfig:"GIN_APP_PORT" required:"true" default:"8080" min:"1000" max:"9999"`
+
+	GamesBackend string `envconfig:"GAMES_BACKEND" default:"memory"`
+	PostgresDSN  string `envconfig:"POSTGRES_DSN"`
+
+	SessionStore  string `envconfig:"SESSION_STORE" default:"cookie"`
+	SessionSecret string `envconfig:"SESSION_SECRET" required:"true"`
+	RedisAddr     string `envconfig:"REDIS_ADDR"`
 }
 
 func LoadConfig() Config {
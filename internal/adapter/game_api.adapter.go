@@ -1,31 +1,38 @@
 package adapter
 
 import (
-	"net/http"
-
+	"log"
 	"strconv"
 
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-contrib/sessions/redis"
 	"github.com/gin-gonic/gin"
 
 	"github.com/GoReactors/backend-learning/config"
-	"github.com/GoReactors/backend-learning/internal/port"
+	http_handler "github.com/GoReactors/backend-learning/internal/adapter/handler/http"
 )
 
-const gamesPrefix = "/games"
+const sessionName = "session"
 
+// GameAPIAdapter owns the gin engine and mounts the auth and games HTTP
+// handlers onto it.
 type GameAPIAdapter struct {
-	app  *gin.Engine
-	port port.GameAPIPort
+	app *gin.Engine
 }
 
-func NewGameAPIAdapter(port port.GameAPIPort) *GameAPIAdapter {
+func NewGameAPIAdapter(cfg config.Config, authHandler *http_handler.AuthHTTPHandler, gamesHandler *http_handler.HTTPHandler) *GameAPIAdapter {
 	adapter := &GameAPIAdapter{
-		app:  gin.Default(),
-		port: port,
+		app: gin.Default(),
 	}
 
-	adapter.app.GET(gamesPrefix+"/:id", adapter.findOne)
-	adapter.app.POST(gamesPrefix, adapter.create)
+	adapter.app.Use(sessions.Sessions(sessionName, newSessionStore(cfg)))
+
+	v2 := adapter.app.Group("/v2")
+
+	http_handler.NewSpecHTTPHandler().RegisterRoutes(v2)
+	authHandler.RegisterRoutes(v2)
+	gamesHandler.RegisterRoutes(v2)
 
 	return adapter
 }
@@ -34,29 +41,18 @@ func (adapter *GameAPIAdapter) Run(cfg config.Config) {
 	adapter.app.Run(":" + strconv.Itoa(cfg.GinAppPort))
 }
 
-func (adapter *GameAPIAdapter) findOne(c *gin.Context) {
-	id := c.Param("id")
-	game, err := adapter.port.FindOne(id)
-	if (err != nil) {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
-		return
-	}
-	c.JSON(http.StatusOK, game)
-}
-
-func (adapter *GameAPIAdapter) create(c *gin.Context) {
-	var request struct {
-		Name string `json:"name"`
-	}
-	if err := c.BindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	var gameName string = request.Name
-	createdGame, err := adapter.port.Create(gameName)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+func newSessionStore(cfg config.Config) sessions.Store {
+	switch cfg.SessionStore {
+	case "redis":
+		store, err := redis.NewStore(10, "tcp", cfg.RedisAddr, "", []byte(cfg.SessionSecret))
+		if err != nil {
+			log.Fatalf("Failed to create redis session store: %v", err)
+		}
+		return store
+	case "cookie":
+		return cookie.NewStore([]byte(cfg.SessionSecret))
+	default:
+		log.Fatalf("Unknown SESSION_STORE %q", cfg.SessionStore)
+		return nil
 	}
-	c.JSON(http.StatusCreated, createdGame)
 }
@@ -2,29 +2,80 @@ package adapter
 
 import (
 	"fmt"
+	"sort"
 
-	game_domain "github.com/GoReactors/backend-learning/internal/application/game/domain"
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"github.com/GoReactors/backend-learning/internal/core/port"
 )
 
-type GameRepositoryAdapter struct {
-	games map[string]*game_domain.Game
+// GamesRepositoryAdapter is an in-memory port.GamesRepository, useful for
+// local development and tests. Production deployments should use
+// PostgresGamesRepository instead.
+type GamesRepositoryAdapter struct {
+	games map[string]domain.Game
 }
 
-func NewGameRepositoryAdapter() *GameRepositoryAdapter {
-	return &GameRepositoryAdapter{
-		games: make(map[string]*game_domain.Game),
+func NewGamesRepositoryAdapter() *GamesRepositoryAdapter {
+	return &GamesRepositoryAdapter{
+		games: make(map[string]domain.Game),
 	}
 }
 
-func (repo *GameRepositoryAdapter) Get(id string) (game_domain.Game, error) {
+func (repo *GamesRepositoryAdapter) Get(id string) (domain.Game, error) {
 	game, exists := repo.games[id]
 	if !exists {
-		return game_domain.Game{}, fmt.Errorf("game not found")
+		return domain.Game{}, fmt.Errorf("game not found")
 	}
-	return *game, nil
+	return game, nil
 }
 
-func (repo *GameRepositoryAdapter) Save(game *game_domain.Game) error {
+func (repo *GamesRepositoryAdapter) Save(game domain.Game) error {
 	repo.games[game.ID] = game
 	return nil
 }
+
+func (repo *GamesRepositoryAdapter) List(filter port.RepoFilter) ([]domain.Game, error) {
+	ids := make([]string, 0, len(repo.games))
+	for id, game := range repo.games {
+		if filter.OwnerID != "" && game.OwnerID != filter.OwnerID {
+			continue
+		}
+		if filter.Status != "" && game.Status != filter.Status {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	ids = paginate(ids, filter.Page, filter.PageSize)
+
+	games := make([]domain.Game, 0, len(ids))
+	for _, id := range ids {
+		games = append(games, repo.games[id])
+	}
+
+	return games, nil
+}
+
+// paginate slices a sorted id list down to the requested page. Page
+// numbering starts at 1; a zero pageSize means no pagination.
+func paginate(ids []string, page uint, pageSize uint) []string {
+	if pageSize == 0 {
+		return ids
+	}
+	if page == 0 {
+		page = 1
+	}
+
+	start := (page - 1) * pageSize
+	if start >= uint(len(ids)) {
+		return []string{}
+	}
+
+	end := start + pageSize
+	if end > uint(len(ids)) {
+		end = uint(len(ids))
+	}
+
+	return ids[start:end]
+}
@@ -0,0 +1,74 @@
+package http_handler
+
+import (
+	"github.com/GoReactors/backend-learning/internal/core/port"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHTTPHandler struct {
+	authService port.AuthService
+}
+
+func NewAuthHTTPHandler(authService port.AuthService) *AuthHTTPHandler {
+	return &AuthHTTPHandler{
+		authService: authService,
+	}
+}
+
+// RegisterRoutes wires the auth endpoints onto router.
+func (hdl *AuthHTTPHandler) RegisterRoutes(router gin.IRouter) {
+	router.POST("/auth/register", hdl.Register)
+	router.POST("/auth/login", hdl.Login)
+	router.POST("/auth/logout", hdl.Logout)
+}
+
+func (hdl *AuthHTTPHandler) Register(c *gin.Context) {
+	var request RegisterRequest
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	user, err := hdl.authService.Register(request.Username, request.Password)
+	if err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(201, user)
+}
+
+func (hdl *AuthHTTPHandler) Login(c *gin.Context) {
+	var request LoginRequest
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	user, err := hdl.authService.Login(request.Username, request.Password)
+	if err != nil {
+		c.AbortWithStatusJSON(401, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set(sessionUserIDKey, user.ID)
+	if err := session.Save(); err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, user)
+}
+
+func (hdl *AuthHTTPHandler) Logout(c *gin.Context) {
+	session := sessions.Default(c)
+	session.Clear()
+	if err := session.Save(); err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.Status(204)
+}
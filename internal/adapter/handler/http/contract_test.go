@@ -0,0 +1,372 @@
+package http_handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"github.com/GoReactors/backend-learning/api"
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"github.com/GoReactors/backend-learning/internal/core/port"
+)
+
+// fakeGamesService is a minimal port.GamesService used to exercise the
+// handlers without a real repository.
+type fakeGamesService struct {
+	game domain.Game
+}
+
+func (f *fakeGamesService) Get(id string) (domain.Game, error) { return f.game, nil }
+
+func (f *fakeGamesService) Create(name string, mode domain.ModeName, size uint, bombs uint, seed int64, ownerID string) (domain.Game, error) {
+	f.game = domain.NewGame("game-1", name, 9, 9, 10, ownerID, 1)
+	return f.game, nil
+}
+
+func (f *fakeGamesService) Reveal(gameID string, x uint, y uint) (domain.Game, error) {
+	return f.game, nil
+}
+
+func (f *fakeGamesService) Flag(gameID string, x uint, y uint) (domain.Game, error) {
+	return f.game, nil
+}
+
+func (f *fakeGamesService) List(filter port.RepoFilter) ([]domain.Game, error) {
+	return []domain.Game{f.game}, nil
+}
+
+func (f *fakeGamesService) Stats(gameID string) (domain.Stats, error) {
+	return domain.Stats{Status: f.game.Status}, nil
+}
+
+func (f *fakeGamesService) Abort(gameID string) (domain.Game, error) {
+	f.game.Status = domain.StatusLost
+	return f.game, nil
+}
+
+// fakeAuthService is a minimal port.AuthService used to exercise the auth
+// handlers without a real user repository.
+type fakeAuthService struct{}
+
+func (f *fakeAuthService) Register(username string, password string) (domain.User, error) {
+	return domain.NewUser("user-1", username, "hashed"), nil
+}
+
+func (f *fakeAuthService) Login(username string, password string) (domain.User, error) {
+	return domain.NewUser("user-1", username, "hashed"), nil
+}
+
+func newContractRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	game := domain.NewGame("game-1", "first game", 9, 9, 10, "user-1", 1)
+	game.Moves = append(game.Moves, domain.Move{Seq: 0, Type: domain.MoveReveal, X: 0, Y: 0})
+	fake := &fakeGamesService{game: game}
+
+	router := gin.New()
+	router.Use(sessions.Sessions("session", cookie.NewStore([]byte("test-secret"))))
+	router.Use(func(c *gin.Context) {
+		sessions.Default(c).Set(sessionUserIDKey, "user-1")
+		c.Next()
+	})
+
+	v2 := router.Group("/v2")
+	NewSpecHTTPHandler().RegisterRoutes(v2)
+	NewHTTPHandler(fake).RegisterRoutes(v2)
+	NewAuthHTTPHandler(&fakeAuthService{}).RegisterRoutes(v2)
+
+	return router
+}
+
+// loadSpecRouter parses the embedded OpenAPI document and builds the
+// router kin-openapi uses to match a request to its schema.
+func loadSpecRouter(t *testing.T) (*openapi3.T, routers.Router) {
+	t.Helper()
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(api.OpenAPIJSON)
+	if err != nil {
+		t.Fatalf("parse embedded openapi spec: %v", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		t.Fatalf("embedded openapi spec is invalid: %v", err)
+	}
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("build spec router: %v", err)
+	}
+
+	return doc, router
+}
+
+// assertMatchesSchema replays req against ginRouter and validates both the
+// request and the response it produced against the embedded OpenAPI spec.
+// requestOpts, when given, replaces the default request validation options.
+func assertMatchesSchema(t *testing.T, ginRouter *gin.Engine, specRouter routers.Router, req *http.Request, requestOpts ...*openapi3filter.Options) *httptest.ResponseRecorder {
+	t.Helper()
+
+	route, pathParams, err := specRouter.FindRoute(req)
+	if err != nil {
+		t.Fatalf("%s %s: no matching route in openapi spec: %v", req.Method, req.URL.Path, err)
+	}
+
+	requestInput := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+	}
+	if len(requestOpts) > 0 {
+		requestInput.Options = requestOpts[0]
+	}
+	if err := openapi3filter.ValidateRequest(context.Background(), requestInput); err != nil {
+		t.Fatalf("%s %s: request does not match schema: %v", req.Method, req.URL.Path, err)
+	}
+
+	rec := httptest.NewRecorder()
+	ginRouter.ServeHTTP(rec, req)
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 rec.Code,
+		Header:                 rec.Header(),
+	}
+	responseInput.SetBodyBytes(rec.Body.Bytes())
+	if err := openapi3filter.ValidateResponse(context.Background(), responseInput); err != nil {
+		t.Fatalf("%s %s: response does not match schema: %v", req.Method, req.URL.Path, err)
+	}
+
+	return rec
+}
+
+// TestOpenAPISpecServed checks that the spec endpoint serves a JSON
+// document declaring openapi 3.0.3 and that the docs page answers 200.
+func TestOpenAPISpecServed(t *testing.T) {
+	router := newContractRouter()
+
+	specReq := httptest.NewRequest(http.MethodGet, "/v2/openapi.json", nil)
+	specRec := httptest.NewRecorder()
+	router.ServeHTTP(specRec, specReq)
+
+	if specRec.Code != http.StatusOK {
+		t.Fatalf("GET /v2/openapi.json: status = %d, want 200", specRec.Code)
+	}
+
+	var spec map[string]any
+	if err := json.Unmarshal(specRec.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("GET /v2/openapi.json: response is not valid JSON: %v", err)
+	}
+	if spec["openapi"] != "3.0.3" {
+		t.Errorf("GET /v2/openapi.json: openapi field = %v, want 3.0.3", spec["openapi"])
+	}
+
+	docsReq := httptest.NewRequest(http.MethodGet, "/v2/docs", nil)
+	docsRec := httptest.NewRecorder()
+	router.ServeHTTP(docsRec, docsReq)
+
+	if docsRec.Code != http.StatusOK {
+		t.Fatalf("GET /v2/docs: status = %d, want 200", docsRec.Code)
+	}
+}
+
+// TestCreateGameMatchesSchema checks that POST /v2/games accepts the
+// CreateGameRequest shape and returns a response validated against the
+// Game schema in api/openapi3.json. The schema declares no required
+// properties, so a response that omits a field still validates.
+func TestCreateGameMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	body, _ := json.Marshal(CreateGameRequest{Name: "my game", Mode: domain.ModeBeginner})
+	req := httptest.NewRequest(http.MethodPost, "/v2/games", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("POST /v2/games: status = %d, want 201", rec.Code)
+	}
+}
+
+// TestMoveRejectsUnknownType checks that an invalid move type returns a
+// response matching the Error schema. The request body deliberately falls
+// outside the MoveType enum, so request body validation is skipped.
+func TestMoveRejectsUnknownType(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	body, _ := json.Marshal(MoveRequest{Type: "not-a-move"})
+	req := httptest.NewRequest(http.MethodPost, "/v2/games/game-1/moves", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req, &openapi3filter.Options{ExcludeRequestBody: true})
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("POST /v2/games/:id/moves: status = %d, want 400", rec.Code)
+	}
+}
+
+// TestListMatchesSchema checks that GET /v2/games returns a response
+// matching its documented array-of-Game schema.
+func TestListMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /v2/games: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestGetMatchesSchema checks that GET /v2/games/:id returns a response
+// matching the Game schema.
+func TestGetMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games/game-1", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /v2/games/:id: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestStatsMatchesSchema checks that GET /v2/games/:id/stats returns a
+// response matching the Stats schema.
+func TestStatsMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games/game-1/stats", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /v2/games/:id/stats: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestAbortMatchesSchema checks that POST /v2/games/:id/abort returns a
+// response matching the Game schema.
+func TestAbortMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v2/games/game-1/abort", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("POST /v2/games/:id/abort: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestListMovesMatchesSchema checks that GET /v2/games/:id/moves returns
+// a response matching its documented array-of-Move schema.
+func TestListMovesMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games/game-1/moves", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /v2/games/:id/moves: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestGetMoveMatchesSchema checks that GET /v2/games/:id/moves/:seq
+// returns a response matching the Move schema.
+func TestGetMoveMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games/game-1/moves/0", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /v2/games/:id/moves/:seq: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestGetMoveMatchesSchemaNotFound checks that an unknown sequence number
+// returns a response matching the Error schema.
+func TestGetMoveMatchesSchemaNotFound(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/games/game-1/moves/99", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GET /v2/games/:id/moves/:seq: status = %d, want 404", rec.Code)
+	}
+}
+
+// TestRegisterMatchesSchema checks that POST /v2/auth/register returns a
+// response matching the User schema.
+func TestRegisterMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	body, _ := json.Marshal(RegisterRequest{Username: "new-user", Password: "hunter2"})
+	req := httptest.NewRequest(http.MethodPost, "/v2/auth/register", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("POST /v2/auth/register: status = %d, want 201", rec.Code)
+	}
+}
+
+// TestLoginMatchesSchema checks that POST /v2/auth/login returns a
+// response matching the User schema.
+func TestLoginMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	body, _ := json.Marshal(LoginRequest{Username: "new-user", Password: "hunter2"})
+	req := httptest.NewRequest(http.MethodPost, "/v2/auth/login", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("POST /v2/auth/login: status = %d, want 200", rec.Code)
+	}
+}
+
+// TestLogoutMatchesSchema checks that POST /v2/auth/logout returns the
+// documented empty 204 response.
+func TestLogoutMatchesSchema(t *testing.T) {
+	ginRouter := newContractRouter()
+	_, specRouter := loadSpecRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v2/auth/logout", nil)
+
+	rec := assertMatchesSchema(t, ginRouter, specRouter, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("POST /v2/auth/logout: status = %d, want 204", rec.Code)
+	}
+}
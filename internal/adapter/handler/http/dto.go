@@ -0,0 +1,43 @@
+package http_handler
+
+import "github.com/GoReactors/backend-learning/internal/core/domain"
+
+// These request/response DTOs are hand-maintained against the schemas in
+// api/openapi3.yml rather than generated. TestDTOsMatchSchema in
+// dto_test.go checks each struct's JSON field set against its schema's
+// properties so the two can't silently drift apart.
+
+// ErrorResponse is the typed error body every endpoint returns, matching
+// the Error schema in api/openapi3.yml.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// CreateGameRequest matches the CreateGameRequest schema in
+// api/openapi3.yml.
+type CreateGameRequest struct {
+	Name  string          `json:"name"`
+	Mode  domain.ModeName `json:"mode"`
+	Size  uint            `json:"size"`
+	Bombs uint            `json:"bombs"`
+	Seed  int64           `json:"seed"`
+}
+
+// MoveRequest matches the MoveRequest schema in api/openapi3.yml.
+type MoveRequest struct {
+	Type domain.MoveType `json:"type"`
+	X    uint            `json:"x"`
+	Y    uint            `json:"y"`
+}
+
+// RegisterRequest matches the RegisterRequest schema in api/openapi3.yml.
+type RegisterRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LoginRequest matches the LoginRequest schema in api/openapi3.yml.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
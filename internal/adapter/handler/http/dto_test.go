@@ -0,0 +1,80 @@
+package http_handler
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/GoReactors/backend-learning/api"
+)
+
+// jsonFields returns the sorted set of JSON field names a struct type
+// serializes as, so it can be compared against an OpenAPI schema's
+// properties.
+func jsonFields(t reflect.Type) []string {
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// schemaFields returns the sorted set of property names declared on the
+// named schema in the embedded OpenAPI document.
+func schemaFields(t *testing.T, doc *openapi3.T, schemaName string) []string {
+	t.Helper()
+
+	schema, ok := doc.Components.Schemas[schemaName]
+	if !ok {
+		t.Fatalf("schema %q not found in embedded openapi spec", schemaName)
+	}
+
+	fields := make([]string, 0, len(schema.Value.Properties))
+	for name := range schema.Value.Properties {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// TestDTOsMatchSchema guards against the hand-written request/response
+// DTOs drifting from api/openapi3.json: each struct's JSON field set must
+// match its schema's declared properties exactly.
+func TestDTOsMatchSchema(t *testing.T) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(api.OpenAPIJSON)
+	if err != nil {
+		t.Fatalf("parse embedded openapi spec: %v", err)
+	}
+
+	tests := []struct {
+		schemaName string
+		dtoType    reflect.Type
+	}{
+		{"Error", reflect.TypeOf(ErrorResponse{})},
+		{"CreateGameRequest", reflect.TypeOf(CreateGameRequest{})},
+		{"MoveRequest", reflect.TypeOf(MoveRequest{})},
+		{"RegisterRequest", reflect.TypeOf(RegisterRequest{})},
+		{"LoginRequest", reflect.TypeOf(LoginRequest{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schemaName, func(t *testing.T) {
+			want := schemaFields(t, doc, tt.schemaName)
+			got := jsonFields(tt.dtoType)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s fields = %v, want %v (matching the %s schema)", tt.dtoType.Name(), got, want, tt.schemaName)
+			}
+		})
+	}
+}
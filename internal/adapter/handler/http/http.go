@@ -1,6 +1,9 @@
 package http_handler
 
 import (
+	"strconv"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
 	"github.com/GoReactors/backend-learning/internal/core/port"
 	"github.com/gin-gonic/gin"
 )
@@ -15,12 +18,161 @@ func NewHTTPHandler(gamesService port.GamesService) *HTTPHandler {
 	}
 }
 
+// RegisterRoutes wires every games endpoint onto router. All routes
+// require a logged-in session, and the ones scoped to a specific game
+// additionally require the caller to own it.
+func (hdl *HTTPHandler) RegisterRoutes(router gin.IRouter) {
+	requireOwner := RequireGameOwner(hdl.gamesService)
+
+	router.POST("/games", RequireLogin(), hdl.Create)
+	router.GET("/games", RequireLogin(), hdl.List)
+	router.GET("/games/:id", RequireLogin(), requireOwner, hdl.Get)
+	router.GET("/games/:id/stats", RequireLogin(), requireOwner, hdl.Stats)
+	router.POST("/games/:id/abort", RequireLogin(), requireOwner, hdl.Abort)
+	router.POST("/games/:id/moves", RequireLogin(), requireOwner, hdl.Move)
+	router.GET("/games/:id/moves", RequireLogin(), requireOwner, hdl.ListMoves)
+	router.GET("/games/:id/moves/:seq", RequireLogin(), requireOwner, hdl.GetMove)
+}
+
+func (hdl *HTTPHandler) Create(c *gin.Context) {
+	var request CreateGameRequest
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if _, err := domain.ResolveMode(request.Mode, request.Size, request.Bombs); err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	ownerID := c.GetString(sessionUserIDKey)
+
+	game, err := hdl.gamesService.Create(request.Name, request.Mode, request.Size, request.Bombs, request.Seed, ownerID)
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(201, game)
+}
+
+// List returns the caller's own games, narrowed by ?status= and paginated
+// via ?page= and ?page_size=. There is no admin role in this API, so the
+// owner filter is always the session user and cannot be overridden by
+// the caller.
+func (hdl *HTTPHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "0"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 0 {
+		pageSize = 0
+	}
+
+	filter := port.RepoFilter{
+		OwnerID:  c.GetString(sessionUserIDKey),
+		Status:   domain.GameStatus(c.Query("status")),
+		Page:     uint(page),
+		PageSize: uint(pageSize),
+	}
+
+	games, err := hdl.gamesService.List(filter)
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, games)
+}
+
 func (hdl *HTTPHandler) Get(c *gin.Context) {
 	game, err := hdl.gamesService.Get(c.Param("id"))
 	if err != nil {
-		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
 		return
 	}
 
 	c.JSON(200, game)
 }
+
+// Stats returns a game's cumulative counters, elapsed time, and outcome.
+func (hdl *HTTPHandler) Stats(c *gin.Context) {
+	stats, err := hdl.gamesService.Stats(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, stats)
+}
+
+// Abort marks a game as lost and freezes further moves against it.
+func (hdl *HTTPHandler) Abort(c *gin.Context) {
+	game, err := hdl.gamesService.Abort(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, game)
+}
+
+// Move applies a reveal or flag move to the game's board.
+func (hdl *HTTPHandler) Move(c *gin.Context) {
+	var request MoveRequest
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	id := c.Param("id")
+
+	var game domain.Game
+	var err error
+	switch request.Type {
+	case domain.MoveReveal:
+		game, err = hdl.gamesService.Reveal(id, request.X, request.Y)
+	case domain.MoveFlag:
+		game, err = hdl.gamesService.Flag(id, request.X, request.Y)
+	default:
+		c.AbortWithStatusJSON(400, ErrorResponse{Message: "unknown move type"})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, game)
+}
+
+// ListMoves returns the full, ordered move history for a game.
+func (hdl *HTTPHandler) ListMoves(c *gin.Context) {
+	game, err := hdl.gamesService.Get(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(200, game.Moves)
+}
+
+// GetMove returns a single move by its sequence number so a client can
+// replay a game move-by-move.
+func (hdl *HTTPHandler) GetMove(c *gin.Context) {
+	game, err := hdl.gamesService.Get(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	seq, err := strconv.Atoi(c.Param("seq"))
+	if err != nil || seq < 0 || seq >= len(game.Moves) {
+		c.AbortWithStatusJSON(404, ErrorResponse{Message: "move not found"})
+		return
+	}
+
+	c.JSON(200, game.Moves[seq])
+}
@@ -0,0 +1,47 @@
+package http_handler
+
+import (
+	"github.com/GoReactors/backend-learning/internal/core/port"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+const sessionUserIDKey = "user_id"
+
+// RequireLogin aborts with 401 unless the request carries a session with
+// a logged-in user.
+func RequireLogin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		userID, ok := session.Get(sessionUserIDKey).(string)
+		if !ok || userID == "" {
+			c.AbortWithStatusJSON(401, ErrorResponse{Message: "login required"})
+			return
+		}
+
+		c.Set(sessionUserIDKey, userID)
+		c.Next()
+	}
+}
+
+// RequireGameOwner aborts with 403 unless the logged-in user owns the
+// game referenced by the :id path parameter; a game that cannot be loaded
+// aborts with 404 instead. Must run after RequireLogin.
+func RequireGameOwner(gamesService port.GamesService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.GetString(sessionUserIDKey)
+
+		game, err := gamesService.Get(c.Param("id"))
+		if err != nil {
+			c.AbortWithStatusJSON(404, ErrorResponse{Message: err.Error()})
+			return
+		}
+
+		if game.OwnerID != userID {
+			c.AbortWithStatusJSON(403, ErrorResponse{Message: "forbidden"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,47 @@
+package http_handler
+
+import (
+	"net/http"
+
+	"github.com/GoReactors/backend-learning/api"
+	"github.com/gin-gonic/gin"
+)
+
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>API docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function() {
+      SwaggerUIBundle({url: '/v2/openapi.json', dom_id: '#swagger-ui'})
+    }
+  </script>
+</body>
+</html>`
+
+// SpecHTTPHandler serves the OpenAPI spec and a Swagger UI page that
+// renders it.
+type SpecHTTPHandler struct{}
+
+func NewSpecHTTPHandler() *SpecHTTPHandler {
+	return &SpecHTTPHandler{}
+}
+
+// RegisterRoutes wires the spec and docs endpoints onto router.
+func (hdl *SpecHTTPHandler) RegisterRoutes(router gin.IRouter) {
+	router.GET("/openapi.json", hdl.Spec)
+	router.GET("/docs", hdl.Docs)
+}
+
+func (hdl *SpecHTTPHandler) Spec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", api.OpenAPIJSON)
+}
+
+func (hdl *SpecHTTPHandler) Docs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(docsPage))
+}
@@ -0,0 +1,200 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"github.com/GoReactors/backend-learning/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresGamesRepository is a port.GamesRepository backed by Postgres. It
+// stores the board as jsonb on the games row and keeps the move history in
+// a separate, ordered games_moves table.
+type PostgresGamesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresGamesRepository(pool *pgxpool.Pool) *PostgresGamesRepository {
+	return &PostgresGamesRepository{
+		pool: pool,
+	}
+}
+
+func (repo *PostgresGamesRepository) Get(id string) (domain.Game, error) {
+	ctx := context.Background()
+
+	var name, ownerID, status string
+	var width, height, bombs, cellsRevealed, flagsPlaced, moveCount uint
+	var boardState []byte
+	var startedAt time.Time
+	var endedAt *time.Time
+
+	row := repo.pool.QueryRow(ctx, `
+		SELECT name, width, height, bombs, status, board_state, owner_id,
+		       created_at, ended_at, cells_revealed, flags_placed, move_count
+		FROM games WHERE id = $1`, id)
+	if err := row.Scan(&name, &width, &height, &bombs, &status, &boardState, &ownerID,
+		&startedAt, &endedAt, &cellsRevealed, &flagsPlaced, &moveCount); err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.Game{}, fmt.Errorf("game not found")
+		}
+		return domain.Game{}, fmt.Errorf("query game: %w", err)
+	}
+
+	var board domain.Board
+	if err := json.Unmarshal(boardState, &board); err != nil {
+		return domain.Game{}, fmt.Errorf("unmarshal board state: %w", err)
+	}
+
+	moves, err := repo.getMoves(ctx, id)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	return domain.Game{
+		ID:      id,
+		Name:    name,
+		Width:   width,
+		Height:  height,
+		Bombs:   bombs,
+		Board:   board,
+		Status:  domain.GameStatus(status),
+		Moves:   moves,
+		OwnerID: ownerID,
+		Stats: domain.GameStats{
+			CellsRevealed: cellsRevealed,
+			FlagsPlaced:   flagsPlaced,
+			MoveCount:     moveCount,
+		},
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+	}, nil
+}
+
+func (repo *PostgresGamesRepository) List(filter port.RepoFilter) ([]domain.Game, error) {
+	ctx := context.Background()
+
+	query := `SELECT id FROM games WHERE 1 = 1`
+	args := []any{}
+
+	if filter.OwnerID != "" {
+		args = append(args, filter.OwnerID)
+		query += fmt.Sprintf(" AND owner_id = $%d", len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, string(filter.Status))
+		query += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	query += " ORDER BY created_at ASC"
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page == 0 {
+			page = 1
+		}
+		args = append(args, filter.PageSize, (page-1)*filter.PageSize)
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	rows, err := repo.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query games: %w", err)
+	}
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan game id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	games := make([]domain.Game, 0, len(ids))
+	for _, id := range ids {
+		game, err := repo.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+func (repo *PostgresGamesRepository) getMoves(ctx context.Context, gameID string) ([]domain.Move, error) {
+	rows, err := repo.pool.Query(ctx,
+		`SELECT seq, type, x, y FROM games_moves WHERE game_id = $1 ORDER BY seq ASC`, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("query moves: %w", err)
+	}
+	defer rows.Close()
+
+	moves := []domain.Move{}
+	for rows.Next() {
+		var move domain.Move
+		var moveType string
+		if err := rows.Scan(&move.Seq, &moveType, &move.X, &move.Y); err != nil {
+			return nil, fmt.Errorf("scan move: %w", err)
+		}
+		move.Type = domain.MoveType(moveType)
+		moves = append(moves, move)
+	}
+
+	return moves, rows.Err()
+}
+
+func (repo *PostgresGamesRepository) Save(game domain.Game) error {
+	ctx := context.Background()
+
+	boardState, err := json.Marshal(game.Board)
+	if err != nil {
+		return fmt.Errorf("marshal board state: %w", err)
+	}
+
+	tx, err := repo.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO games (id, name, width, height, bombs, board_state, status, owner_id,
+		                    ended_at, cells_revealed, flags_placed, move_count)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE
+		SET board_state = EXCLUDED.board_state, status = EXCLUDED.status,
+		    ended_at = EXCLUDED.ended_at, cells_revealed = EXCLUDED.cells_revealed,
+		    flags_placed = EXCLUDED.flags_placed, move_count = EXCLUDED.move_count`,
+		game.ID, game.Name, game.Width, game.Height, game.Bombs, boardState, string(game.Status), game.OwnerID,
+		game.EndedAt, game.Stats.CellsRevealed, game.Stats.FlagsPlaced, game.Stats.MoveCount)
+	if err != nil {
+		return fmt.Errorf("upsert game: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM games_moves WHERE game_id = $1`, game.ID); err != nil {
+		return fmt.Errorf("clear moves: %w", err)
+	}
+
+	for _, move := range game.Moves {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO games_moves (game_id, seq, type, x, y)
+			VALUES ($1, $2, $3, $4, $5)`,
+			game.ID, move.Seq, string(move.Type), move.X, move.Y)
+		if err != nil {
+			return fmt.Errorf("insert move: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
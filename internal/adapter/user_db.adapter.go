@@ -0,0 +1,32 @@
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+)
+
+// UsersRepositoryAdapter is an in-memory port.UsersRepository, useful for
+// local development and tests.
+type UsersRepositoryAdapter struct {
+	users map[string]domain.User
+}
+
+func NewUsersRepositoryAdapter() *UsersRepositoryAdapter {
+	return &UsersRepositoryAdapter{
+		users: make(map[string]domain.User),
+	}
+}
+
+func (repo *UsersRepositoryAdapter) GetByUsername(username string) (domain.User, error) {
+	user, exists := repo.users[username]
+	if !exists {
+		return domain.User{}, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
+
+func (repo *UsersRepositoryAdapter) Save(user domain.User) error {
+	repo.users[user.Username] = user
+	return nil
+}
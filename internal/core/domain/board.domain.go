@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"errors"
+	"math/rand"
+)
+
+type CellState string
+
+const (
+	CellHidden   CellState = "hidden"
+	CellRevealed CellState = "revealed"
+	CellFlagged  CellState = "flagged"
+)
+
+type Cell struct {
+	Bomb     bool      `json:"-"`
+	State    CellState `json:"state"`
+	Adjacent uint      `json:"adjacent"`
+}
+
+type Board struct {
+	Width  uint     `json:"width"`
+	Height uint     `json:"height"`
+	Cells  [][]Cell `json:"cells"`
+}
+
+// NewBoard builds a width x height board and seeds its bomb placement
+// from seed, so the same seed always produces the same layout.
+func NewBoard(width uint, height uint, bombs uint, seed int64) Board {
+	board := Board{
+		Width:  width,
+		Height: height,
+		Cells:  make([][]Cell, height),
+	}
+	for y := range board.Cells {
+		board.Cells[y] = make([]Cell, width)
+		for x := range board.Cells[y] {
+			board.Cells[y][x] = Cell{State: CellHidden}
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	board.placeBombs(rng, bombs)
+	board.computeAdjacency()
+
+	return board
+}
+
+func (b *Board) placeBombs(rng *rand.Rand, bombs uint) {
+	placed := uint(0)
+	for placed < bombs {
+		x := uint(rng.Intn(int(b.Width)))
+		y := uint(rng.Intn(int(b.Height)))
+		if b.Cells[y][x].Bomb {
+			continue
+		}
+		b.Cells[y][x].Bomb = true
+		placed++
+	}
+}
+
+func (b *Board) computeAdjacency() {
+	for y := range b.Cells {
+		for x := range b.Cells[y] {
+			if b.Cells[y][x].Bomb {
+				continue
+			}
+			b.Cells[y][x].Adjacent = b.countAdjacentBombs(uint(x), uint(y))
+		}
+	}
+}
+
+func (b *Board) countAdjacentBombs(x, y uint) uint {
+	count := uint(0)
+	for _, n := range b.neighbors(x, y) {
+		if b.Cells[n.y][n.x].Bomb {
+			count++
+		}
+	}
+	return count
+}
+
+type coord struct {
+	x, y uint
+}
+
+func (b *Board) neighbors(x, y uint) []coord {
+	var result []coord
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			nx, ny := int(x)+dx, int(y)+dy
+			if nx < 0 || ny < 0 || nx >= int(b.Width) || ny >= int(b.Height) {
+				continue
+			}
+			result = append(result, coord{x: uint(nx), y: uint(ny)})
+		}
+	}
+	return result
+}
+
+// Reveal opens the cell at (x, y), cascading through adjacent zero-count
+// cells, and reports whether the reveal hit a bomb and how many cells
+// were newly revealed. Flagged or already-revealed cells are left
+// untouched and report hitBomb=false, revealed=0 so callers don't mistake
+// a no-op for an actual mine hit.
+func (b *Board) Reveal(x, y uint) (bool, uint, error) {
+	if x >= b.Width || y >= b.Height {
+		return false, 0, errors.New("coordinates out of bounds")
+	}
+
+	if b.Cells[y][x].State != CellHidden {
+		return false, 0, nil
+	}
+
+	bomb := b.Cells[y][x].Bomb
+	revealed := b.revealCascade(x, y)
+
+	return bomb, revealed, nil
+}
+
+func (b *Board) revealCascade(x, y uint) uint {
+	cell := &b.Cells[y][x]
+	if cell.State != CellHidden {
+		return 0
+	}
+
+	cell.State = CellRevealed
+	revealed := uint(1)
+	if cell.Bomb || cell.Adjacent != 0 {
+		return revealed
+	}
+
+	for _, n := range b.neighbors(x, y) {
+		revealed += b.revealCascade(n.x, n.y)
+	}
+
+	return revealed
+}
+
+// Flag toggles the flagged state of a hidden cell and reports whether the
+// cell is flagged afterwards, plus whether the call actually changed
+// anything; revealed cells are left untouched and report changed=false so
+// callers don't mistake a no-op for a toggle.
+func (b *Board) Flag(x, y uint) (flagged bool, changed bool, err error) {
+	if x >= b.Width || y >= b.Height {
+		return false, false, errors.New("coordinates out of bounds")
+	}
+
+	cell := &b.Cells[y][x]
+	switch cell.State {
+	case CellHidden:
+		cell.State = CellFlagged
+	case CellFlagged:
+		cell.State = CellHidden
+	default:
+		return false, false, nil
+	}
+
+	return cell.State == CellFlagged, true, nil
+}
+
+// Won reports whether every non-bomb cell has been revealed.
+func (b *Board) Won() bool {
+	for y := range b.Cells {
+		for x := range b.Cells[y] {
+			cell := b.Cells[y][x]
+			if !cell.Bomb && cell.State != CellRevealed {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "testing"
+
+func TestFlagRevealedCellIsANoOp(t *testing.T) {
+	board := NewBoard(5, 5, 0, 1)
+
+	if _, _, err := board.Reveal(0, 0); err != nil {
+		t.Fatalf("Reveal(0, 0): unexpected error: %v", err)
+	}
+
+	flagged, changed, err := board.Flag(0, 0)
+	if err != nil {
+		t.Fatalf("Flag(0, 0) on revealed cell: unexpected error: %v", err)
+	}
+	if changed {
+		t.Error("Flag(0, 0) on revealed cell: changed = true, want false")
+	}
+	if flagged {
+		t.Error("Flag(0, 0) on revealed cell: flagged = true, want false")
+	}
+	if board.Cells[0][0].State != CellRevealed {
+		t.Errorf("Flag(0, 0) on revealed cell: state = %s, want %s", board.Cells[0][0].State, CellRevealed)
+	}
+}
+
+func TestRevealFlaggedCellIsANoOp(t *testing.T) {
+	board := NewBoard(5, 5, 0, 1)
+
+	if _, _, err := board.Flag(0, 0); err != nil {
+		t.Fatalf("Flag(0, 0): unexpected error: %v", err)
+	}
+
+	hitBomb, revealed, err := board.Reveal(0, 0)
+	if err != nil {
+		t.Fatalf("Reveal(0, 0) on flagged cell: unexpected error: %v", err)
+	}
+	if hitBomb {
+		t.Error("Reveal(0, 0) on flagged cell: hitBomb = true, want false")
+	}
+	if revealed != 0 {
+		t.Errorf("Reveal(0, 0) on flagged cell: revealed = %d, want 0", revealed)
+	}
+	if board.Cells[0][0].State != CellFlagged {
+		t.Errorf("Reveal(0, 0) on flagged cell: state = %s, want %s", board.Cells[0][0].State, CellFlagged)
+	}
+}
+
+func TestFlagTogglesHiddenCell(t *testing.T) {
+	board := NewBoard(5, 5, 0, 1)
+
+	flagged, changed, err := board.Flag(1, 1)
+	if err != nil {
+		t.Fatalf("Flag(1, 1): unexpected error: %v", err)
+	}
+	if !changed || !flagged {
+		t.Errorf("Flag(1, 1) first call: flagged = %v, changed = %v, want true, true", flagged, changed)
+	}
+
+	flagged, changed, err = board.Flag(1, 1)
+	if err != nil {
+		t.Fatalf("Flag(1, 1) second call: unexpected error: %v", err)
+	}
+	if !changed || flagged {
+		t.Errorf("Flag(1, 1) second call: flagged = %v, changed = %v, want false, true", flagged, changed)
+	}
+}
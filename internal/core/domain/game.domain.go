@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type GameStatus string
+
+const (
+	StatusInProgress GameStatus = "in_progress"
+	StatusWon        GameStatus = "won"
+	StatusLost       GameStatus = "lost"
+)
+
+type Game struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Width     uint       `json:"width"`
+	Height    uint       `json:"height"`
+	Bombs     uint       `json:"bombs"`
+	Board     Board      `json:"board"`
+	Status    GameStatus `json:"status"`
+	Moves     []Move     `json:"moves"`
+	OwnerID   string     `json:"ownerId"`
+	Stats     GameStats  `json:"stats"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+func NewGame(id string, name string, width uint, height uint, bombs uint, ownerID string, seed int64) Game {
+	return Game{
+		ID:        id,
+		Name:      name,
+		Width:     width,
+		Height:    height,
+		Bombs:     bombs,
+		Board:     NewBoard(width, height, bombs, seed),
+		Status:    StatusInProgress,
+		Moves:     []Move{},
+		OwnerID:   ownerID,
+		StartedAt: time.Now(),
+	}
+}
+
+// Finish marks the game as over, recording the end time used by Stats to
+// compute the elapsed duration.
+func (g *Game) Finish(status GameStatus) {
+	g.Status = status
+	endedAt := time.Now()
+	g.EndedAt = &endedAt
+}
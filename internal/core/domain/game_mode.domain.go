@@ -0,0 +1,58 @@
+package domain
+
+import "errors"
+
+type ModeName string
+
+const (
+	ModeBeginner     ModeName = "beginner"
+	ModeIntermediate ModeName = "intermediate"
+	ModeExpert       ModeName = "expert"
+	ModeCustom       ModeName = "custom"
+)
+
+const (
+	MinCustomSize  = 5
+	MaxCustomSize  = 50
+	MaxBombDensity = 0.9
+)
+
+type GameMode struct {
+	Name   ModeName
+	Width  uint
+	Height uint
+	Bombs  uint
+}
+
+// presets maps each named mode to its fixed board dimensions, matching
+// the classic Minesweeper difficulty tiers exactly, including expert's
+// non-square 30x16 grid.
+var presets = map[ModeName]GameMode{
+	ModeBeginner:     {Name: ModeBeginner, Width: 9, Height: 9, Bombs: 10},
+	ModeIntermediate: {Name: ModeIntermediate, Width: 16, Height: 16, Bombs: 40},
+	ModeExpert:       {Name: ModeExpert, Width: 30, Height: 16, Bombs: 99},
+}
+
+// ResolveMode validates the requested mode and returns the concrete board
+// dimensions to create it with. Preset modes ignore the caller-supplied
+// size and bombs in favour of their fixed values; custom mode builds a
+// square board and validates size and bombs against the allowed bounds.
+func ResolveMode(mode ModeName, size uint, bombs uint) (GameMode, error) {
+	if mode != ModeCustom {
+		preset, ok := presets[mode]
+		if !ok {
+			return GameMode{}, errors.New("unknown game mode")
+		}
+		return preset, nil
+	}
+
+	if size < MinCustomSize || size > MaxCustomSize {
+		return GameMode{}, errors.New("custom size is out of bounds")
+	}
+
+	if bombs == 0 || float64(bombs) > float64(size*size)*MaxBombDensity {
+		return GameMode{}, errors.New("custom bomb density is out of bounds")
+	}
+
+	return GameMode{Name: ModeCustom, Width: size, Height: size, Bombs: bombs}, nil
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "testing"
+
+func TestResolveModePresets(t *testing.T) {
+	tests := []struct {
+		mode          ModeName
+		wantWidth     uint
+		wantHeight    uint
+		wantBombCount uint
+	}{
+		{ModeBeginner, 9, 9, 10},
+		{ModeIntermediate, 16, 16, 40},
+		{ModeExpert, 30, 16, 99},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveMode(tt.mode, 0, 0)
+		if err != nil {
+			t.Fatalf("ResolveMode(%s): unexpected error: %v", tt.mode, err)
+		}
+		if got.Width != tt.wantWidth || got.Height != tt.wantHeight || got.Bombs != tt.wantBombCount {
+			t.Errorf("ResolveMode(%s) = %+v, want width %d height %d bombs %d", tt.mode, got, tt.wantWidth, tt.wantHeight, tt.wantBombCount)
+		}
+	}
+}
+
+func TestResolveModeCustom(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    uint
+		bombs   uint
+		wantErr bool
+	}{
+		{"valid", 10, 20, false},
+		{"size too small", 4, 5, true},
+		{"size too large", 51, 100, true},
+		{"zero bombs", 10, 0, true},
+		{"bomb density too high", 10, 91, true},
+		{"bomb density at limit", 10, 90, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveMode(ModeCustom, tt.size, tt.bombs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveMode(custom, %d, %d) error = %v, wantErr %v", tt.size, tt.bombs, err, tt.wantErr)
+			}
+			if err == nil && (got.Width != tt.size || got.Height != tt.size) {
+				t.Errorf("ResolveMode(custom, %d, %d) = %+v, want a square board of size %d", tt.size, tt.bombs, got, tt.size)
+			}
+		})
+	}
+}
+
+func TestResolveModeUnknown(t *testing.T) {
+	if _, err := ResolveMode("not-a-mode", 10, 10); err == nil {
+		t.Error("ResolveMode with unknown mode: expected error, got nil")
+	}
+}
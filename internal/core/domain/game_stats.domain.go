@@ -0,0 +1,20 @@
+package domain
+
+// GameStats is maintained incrementally by the reveal/flag service methods
+// so that looking up a game's stats never requires rescanning its board.
+type GameStats struct {
+	CellsRevealed uint `json:"cellsRevealed"`
+	FlagsPlaced   uint `json:"flagsPlaced"`
+	MoveCount     uint `json:"moveCount"`
+}
+
+// Stats is the read-only view returned by GamesService.Stats, combining
+// the incrementally-tracked counters with the game's current status and
+// elapsed play time.
+type Stats struct {
+	CellsRevealed uint       `json:"cellsRevealed"`
+	FlagsPlaced   uint       `json:"flagsPlaced"`
+	MoveCount     uint       `json:"moveCount"`
+	ElapsedMs     int64      `json:"elapsedMs"`
+	Status        GameStatus `json:"status"`
+}
@@ -0,0 +1,17 @@
+package domain
+
+type MoveType string
+
+const (
+	MoveReveal MoveType = "reveal"
+	MoveFlag   MoveType = "flag"
+)
+
+// Move is a single recorded action against a game's board, numbered by
+// its position in the game's history so it can be replayed in order.
+type Move struct {
+	Seq  uint     `json:"seq"`
+	Type MoveType `json:"type"`
+	X    uint     `json:"x"`
+	Y    uint     `json:"y"`
+}
@@ -0,0 +1,15 @@
+package domain
+
+type User struct {
+	ID           string `json:"id"`
+	Username     string `json:"username"`
+	PasswordHash string `json:"-"`
+}
+
+func NewUser(id string, username string, passwordHash string) User {
+	return User{
+		ID:           id,
+		Username:     username,
+		PasswordHash: passwordHash,
+	}
+}
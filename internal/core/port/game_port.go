@@ -2,12 +2,27 @@ package port
 
 import "github.com/GoReactors/backend-learning/internal/core/domain"
 
+// RepoFilter narrows a games listing by owner and/or status and paginates
+// the result. A zero PageSize means "no pagination, return every match".
+type RepoFilter struct {
+	OwnerID  string
+	Status   domain.GameStatus
+	Page     uint
+	PageSize uint
+}
+
 type GamesRepository interface {
 	Get(id string) (domain.Game, error)
 	Save(domain.Game) error
+	List(filter RepoFilter) ([]domain.Game, error)
 }
 
 type GamesService interface {
 	Get(id string) (domain.Game, error)
-	Create(name string, size uint, bombs uint) (domain.Game, error)
+	Create(name string, mode domain.ModeName, size uint, bombs uint, seed int64, ownerID string) (domain.Game, error)
+	Reveal(gameID string, x uint, y uint) (domain.Game, error)
+	Flag(gameID string, x uint, y uint) (domain.Game, error)
+	List(filter RepoFilter) ([]domain.Game, error)
+	Stats(gameID string) (domain.Stats, error)
+	Abort(gameID string) (domain.Game, error)
 }
@@ -0,0 +1,13 @@
+package port
+
+import "github.com/GoReactors/backend-learning/internal/core/domain"
+
+type UsersRepository interface {
+	GetByUsername(username string) (domain.User, error)
+	Save(domain.User) error
+}
+
+type AuthService interface {
+	Register(username string, password string) (domain.User, error)
+	Login(username string, password string) (domain.User, error)
+}
@@ -0,0 +1,18 @@
+package auth_service
+
+import (
+	"github.com/GoReactors/backend-learning/internal/core/port"
+	"github.com/codemodus/uidgen"
+)
+
+type AuthService struct {
+	usersRepository port.UsersRepository
+	uidGen          uidgen.UIDGen
+}
+
+func New(usersRepository port.UsersRepository, uidGen uidgen.UIDGen) *AuthService {
+	return &AuthService{
+		usersRepository: usersRepository,
+		uidGen:          uidGen,
+	}
+}
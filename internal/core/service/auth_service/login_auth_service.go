@@ -0,0 +1,21 @@
+package auth_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (srv *AuthService) Login(username string, password string) (domain.User, error) {
+	user, err := srv.usersRepository.GetByUsername(username)
+	if err != nil {
+		return domain.User{}, errors.New("invalid username or password")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return domain.User{}, errors.New("invalid username or password")
+	}
+
+	return user, nil
+}
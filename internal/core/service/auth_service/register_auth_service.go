@@ -0,0 +1,27 @@
+package auth_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (srv *AuthService) Register(username string, password string) (domain.User, error) {
+	if _, err := srv.usersRepository.GetByUsername(username); err == nil {
+		return domain.User{}, errors.New("username is already taken")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return domain.User{}, errors.New("failed to hash password")
+	}
+
+	user := domain.NewUser(srv.uidGen.UID().String(), username, string(passwordHash))
+
+	if err := srv.usersRepository.Save(user); err != nil {
+		return domain.User{}, errors.New("create user into repository has failed")
+	}
+
+	return user, nil
+}
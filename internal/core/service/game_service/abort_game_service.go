@@ -0,0 +1,26 @@
+package game_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+)
+
+func (srv *GameService) Abort(gameID string) (domain.Game, error) {
+	game, err := srv.gamesRepository.Get(gameID)
+	if err != nil {
+		return domain.Game{}, errors.New("get game from repository has failed")
+	}
+
+	if game.Status != domain.StatusInProgress {
+		return domain.Game{}, errors.New("game is already finished")
+	}
+
+	game.Finish(domain.StatusLost)
+
+	if err := srv.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New("save game into repository has failed")
+	}
+
+	return game, nil
+}
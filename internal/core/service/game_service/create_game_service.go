@@ -2,16 +2,22 @@ package game_service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/GoReactors/backend-learning/internal/core/domain"
 )
 
-func (srv *GameService) Create(name string, size uint, bombs uint) (domain.Game, error) {
-	if bombs >= size*size {
-		return domain.Game{}, errors.New("the number of bombs is invalid")
+func (srv *GameService) Create(name string, mode domain.ModeName, size uint, bombs uint, seed int64, ownerID string) (domain.Game, error) {
+	resolved, err := domain.ResolveMode(mode, size, bombs)
+	if err != nil {
+		return domain.Game{}, err
 	}
 
-	game := domain.NewGame(srv.uidGen.UID().String(), name, size, bombs)
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	game := domain.NewGame(srv.uidGen.UID().String(), name, resolved.Width, resolved.Height, resolved.Bombs, ownerID, seed)
 
 	if err := srv.gamesRepository.Save(game); err != nil {
 		return domain.Game{}, errors.New("create game into repository has failed")
@@ -0,0 +1,45 @@
+package game_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+)
+
+func (srv *GameService) Flag(gameID string, x uint, y uint) (domain.Game, error) {
+	game, err := srv.gamesRepository.Get(gameID)
+	if err != nil {
+		return domain.Game{}, errors.New("get game from repository has failed")
+	}
+
+	if game.Status != domain.StatusInProgress {
+		return domain.Game{}, errors.New("game is already finished")
+	}
+
+	flagged, changed, err := game.Board.Flag(x, y)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	game.Stats.MoveCount++
+	if changed {
+		if flagged {
+			game.Stats.FlagsPlaced++
+		} else {
+			game.Stats.FlagsPlaced--
+		}
+	}
+
+	game.Moves = append(game.Moves, domain.Move{
+		Seq:  uint(len(game.Moves)),
+		Type: domain.MoveFlag,
+		X:    x,
+		Y:    y,
+	})
+
+	if err := srv.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New("save game into repository has failed")
+	}
+
+	return game, nil
+}
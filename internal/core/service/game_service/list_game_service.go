@@ -0,0 +1,17 @@
+package game_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+	"github.com/GoReactors/backend-learning/internal/core/port"
+)
+
+func (srv *GameService) List(filter port.RepoFilter) ([]domain.Game, error) {
+	games, err := srv.gamesRepository.List(filter)
+	if err != nil {
+		return nil, errors.New("list games from repository has failed")
+	}
+
+	return games, nil
+}
@@ -0,0 +1,46 @@
+package game_service
+
+import (
+	"errors"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+)
+
+func (srv *GameService) Reveal(gameID string, x uint, y uint) (domain.Game, error) {
+	game, err := srv.gamesRepository.Get(gameID)
+	if err != nil {
+		return domain.Game{}, errors.New("get game from repository has failed")
+	}
+
+	if game.Status != domain.StatusInProgress {
+		return domain.Game{}, errors.New("game is already finished")
+	}
+
+	hitBomb, revealed, err := game.Board.Reveal(x, y)
+	if err != nil {
+		return domain.Game{}, err
+	}
+
+	game.Stats.MoveCount++
+	game.Stats.CellsRevealed += revealed
+
+	game.Moves = append(game.Moves, domain.Move{
+		Seq:  uint(len(game.Moves)),
+		Type: domain.MoveReveal,
+		X:    x,
+		Y:    y,
+	})
+
+	switch {
+	case hitBomb:
+		game.Finish(domain.StatusLost)
+	case game.Board.Won():
+		game.Finish(domain.StatusWon)
+	}
+
+	if err := srv.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New("save game into repository has failed")
+	}
+
+	return game, nil
+}
@@ -0,0 +1,28 @@
+package game_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GoReactors/backend-learning/internal/core/domain"
+)
+
+func (srv *GameService) Stats(gameID string) (domain.Stats, error) {
+	game, err := srv.gamesRepository.Get(gameID)
+	if err != nil {
+		return domain.Stats{}, errors.New("get game from repository has failed")
+	}
+
+	endedAt := time.Now()
+	if game.EndedAt != nil {
+		endedAt = *game.EndedAt
+	}
+
+	return domain.Stats{
+		CellsRevealed: game.Stats.CellsRevealed,
+		FlagsPlaced:   game.Stats.FlagsPlaced,
+		MoveCount:     game.Stats.MoveCount,
+		ElapsedMs:     endedAt.Sub(game.StartedAt).Milliseconds(),
+		Status:        game.Status,
+	}, nil
+}